@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"sync"
 	"time"
 
 	"golang.org/x/net/context"
@@ -57,8 +58,9 @@ const (
 	publishStatusInterval = 10 * time.Second
 )
 
-// errNeedsBootstrap indicates the node should be used as the seed of
-// a new cluster.
+// errNeedsBootstrap indicates the node has no initialized stores and no
+// instructions for joining an existing cluster, and must therefore wait for
+// an operator to run `cockroach init` against it.
 var errNeedsBootstrap = errors.New("node has no initialized stores and no instructions for joining an existing cluster")
 
 // errCannotJoinSelf indicates that a node was started with no initialized
@@ -66,6 +68,25 @@ var errNeedsBootstrap = errors.New("node has no initialized stores and no instru
 // progress in this state.
 var errCannotJoinSelf = errors.New("an uninitialized node cannot specify its own address to join a cluster")
 
+// errAlreadyInitialized is returned by Node.Init when called against a node
+// which already has initialized stores (or has already processed an Init
+// call).
+var errAlreadyInitialized = errors.New("node is already initialized")
+
+// errHasJoinTarget is returned by Node.Init when called against a node that
+// was started with --join pointing at another node. That node's start()
+// goroutine is off in connectGossip, not waitForInit, so it has no way to
+// notice initDoneCh closing; bootstrapping it directly here would wedge it
+// with a cluster stamped into its engines but never wired into n.stores.
+// Init belongs on the node operators run without --join instead.
+var errHasJoinTarget = errors.New("node was started with --join and cannot be the target of Init; " +
+	"run it against the node started without --join instead")
+
+// uninitializedWarnInterval is how often a node with no initialized stores
+// logs a reminder that it is waiting for `cockroach init` to be run. Mirrors
+// the warn-and-retry pattern gossip itself uses while waiting to connect.
+const uninitializedWarnInterval = 10 * time.Second
+
 // A Node manages a map of stores (by store ID) for which it serves
 // traffic. A node is the top-level data structure. There is one node
 // instance per process. A node accepts incoming RPCs and services
@@ -84,6 +105,21 @@ type Node struct {
 	feed       status.NodeEventFeed   // Feed publisher for local events
 	status     *status.NodeStatusMonitor
 	startedAt  int64
+
+	engines  []engine.Engine // Engines passed to initStores, needed by Init
+	engineMu sync.Mutex      // serializes initStores and executeInit's access to engines
+
+	initMu         sync.Mutex    // protects initialized, selfAdvertised and the fields Init sets
+	initialized    bool          // true once this node's stores are initialized
+	initDoneCh     chan struct{} // closed by executeInit once bootstrapCluster succeeds
+	selfAdvertised bool          // true while advertiseUninitialized has us as our own resolver
+
+	healthCheckers []HealthChecker        // evaluated by runHealthChecks
+	healthMu       sync.Mutex             // protects healthAlerts
+	healthAlerts   map[string]HealthAlert // currently active alerts, by Check
+
+	feedMu    sync.Mutex // protects feed and feedReady
+	feedReady bool       // true once feed has been assigned by setFeed
 }
 
 // allocateNodeID increments the node id generator key to allocate
@@ -183,13 +219,153 @@ func bootstrapCluster(engines []engine.Engine) (string, error) {
 	return clusterID, nil
 }
 
+// AlertSeverity indicates how urgently a HealthAlert should be acted upon.
+type AlertSeverity int
+
+const (
+	_ AlertSeverity = iota
+	// AlertSeverityWarning indicates a condition worth an operator's
+	// attention which is not yet impacting cluster availability.
+	AlertSeverityWarning
+	// AlertSeverityCritical indicates a condition likely to be causing, or
+	// about to cause, unavailability or a consistency violation.
+	AlertSeverityCritical
+)
+
+// String implements fmt.Stringer.
+func (s AlertSeverity) String() string {
+	switch s {
+	case AlertSeverityWarning:
+		return "warning"
+	case AlertSeverityCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// HealthAlert is a structured report of a condition affecting this node's
+// health, as produced by a HealthChecker: a typed record that ops tooling
+// and the admin UI can render directly instead of scraping log lines.
+type HealthAlert struct {
+	// Check names the HealthChecker that raised the alert, e.g.
+	// "uninitialized-cluster".
+	Check string
+	// Severity indicates how urgently the alert should be acted upon.
+	Severity AlertSeverity
+	// Message describes the condition in operator-facing terms.
+	Message string
+	// RemediationHint suggests how an operator might resolve the condition.
+	RemediationHint string
+	// FirstSeen is the wall time, in nanoseconds since the Unix epoch, at
+	// which this condition was first observed still ongoing. It's filled in
+	// by runHealthChecks, not by the HealthChecker itself.
+	FirstSeen int64
+}
+
+// HealthChecker evaluates a single aspect of node health, returning a
+// HealthAlert describing the problem if one is found, or nil if the node is
+// healthy with respect to this check.
+type HealthChecker func(n *Node) *HealthAlert
+
+// defaultHealthCheckers is the set of HealthCheckers run by every Node
+// unless overridden.
+//
+// A stalled-Raft-groups checker is intentionally not included here:
+// detecting it needs per-replica Raft status (commit/applied index lag,
+// missing leader) that storage.Store doesn't yet expose to this package.
+// Add it once that instrumentation lands.
+var defaultHealthCheckers = []HealthChecker{
+	checkUninitializedCluster,
+	checkUnreachableJoinPeers,
+	checkStoreCapacity,
+}
+
+// checkUninitializedCluster alerts while a node has no initialized stores,
+// whether it's blocked in waitForInit waiting for `cockroach init`, or
+// still connecting to an existing cluster via its --join target. The hint
+// doesn't distinguish the two: Init is only valid against the former, and
+// now rejects the latter outright (see executeInit, errHasJoinTarget)
+// rather than silently wedging it.
+func checkUninitializedCluster(n *Node) *HealthAlert {
+	if n.isInitialized() {
+		return nil
+	}
+	return &HealthAlert{
+		Check:           "uninitialized-cluster",
+		Severity:        AlertSeverityCritical,
+		Message:         "node has no initialized stores and is waiting for `cockroach init` or for its --join target to supply a cluster ID",
+		RemediationHint: "run `cockroach init` against this node, or restart it with --join pointing at an already-initialized cluster",
+	}
+}
+
+// checkUnreachableJoinPeers alerts if this node has configured resolvers
+// (typically from --join) but gossip has not yet managed to connect through
+// any of them. It stays quiet while the node is only self-advertising as a
+// resolver for `cockroach init` to find (see advertiseUninitialized):
+// checkUninitializedCluster already reports that condition correctly, and
+// without this guard a node waiting for init would also report itself
+// unable to reach its own address.
+func checkUnreachableJoinPeers(n *Node) *HealthAlert {
+	if n.isSelfAdvertised() {
+		return nil
+	}
+	resolvers := n.ctx.Gossip.GetResolvers()
+	if len(resolvers) == 0 {
+		return nil
+	}
+	select {
+	case <-n.ctx.Gossip.Connected:
+		return nil
+	default:
+	}
+	return &HealthAlert{
+		Check:           "unreachable-join-peers",
+		Severity:        AlertSeverityWarning,
+		Message:         fmt.Sprintf("unable to reach any of %d configured join address(es)", len(resolvers)),
+		RemediationHint: "verify network connectivity and that --join addresses are correct and reachable",
+	}
+}
+
+// lowStoreCapacityThreshold is the fraction of available disk capacity
+// below which checkStoreCapacity raises an alert.
+const lowStoreCapacityThreshold = 0.10
+
+// checkStoreCapacity alerts if any store on this node has less than
+// lowStoreCapacityThreshold of its disk capacity available.
+func checkStoreCapacity(n *Node) *HealthAlert {
+	var alert *HealthAlert
+	_ = n.stores.VisitStores(func(s *storage.Store) error {
+		if alert != nil {
+			return nil
+		}
+		capacity, err := s.Capacity()
+		if err != nil || capacity.Capacity <= 0 {
+			return nil
+		}
+		if available := float64(capacity.Available) / float64(capacity.Capacity); available < lowStoreCapacityThreshold {
+			alert = &HealthAlert{
+				Check:           "store-capacity",
+				Severity:        AlertSeverityWarning,
+				Message:         fmt.Sprintf("store %s has only %.1f%% disk capacity available", s, available*100),
+				RemediationHint: "add capacity to the cluster or let the allocator rebalance ranges off this store",
+			}
+		}
+		return nil
+	})
+	return alert
+}
+
 // NewNode returns a new instance of Node.
 func NewNode(ctx storage.StoreContext, registry *metric.Registry, stopper *stop.Stopper, subRegistries []status.NodeSubregistry) *Node {
 	return &Node{
-		ctx:     ctx,
-		stopper: stopper,
-		status:  status.NewNodeStatusMonitor(registry, subRegistries),
-		stores:  storage.NewStores(ctx.Clock),
+		ctx:            ctx,
+		stopper:        stopper,
+		status:         status.NewNodeStatusMonitor(registry, subRegistries),
+		stores:         storage.NewStores(ctx.Clock),
+		initDoneCh:     make(chan struct{}),
+		healthCheckers: defaultHealthCheckers,
+		healthAlerts:   make(map[string]HealthAlert),
 	}
 }
 
@@ -244,53 +420,90 @@ func (n *Node) initNodeID(id roachpb.NodeID) {
 	}
 }
 
+// setFeed installs the node's event feed, created once the NodeID is known,
+// and marks it ready for use by publishHealthAlert. Until this is called,
+// runHealthChecks (reachable immediately via Node.Health, before the node's
+// stores are even initialized) must not touch n.feed: it's the zero value.
+func (n *Node) setFeed(feed status.NodeEventFeed) {
+	n.feedMu.Lock()
+	defer n.feedMu.Unlock()
+	n.feed = feed
+	n.feedReady = true
+}
+
+// publishHealthAlert publishes alert to the event feed if it's ready yet, or
+// is a silent no-op otherwise, e.g. for an alert raised by a Node.Health
+// call that lands before the node has a NodeID and thus an event feed.
+func (n *Node) publishHealthAlert(alert HealthAlert) {
+	n.feedMu.Lock()
+	defer n.feedMu.Unlock()
+	if !n.feedReady {
+		return
+	}
+	n.feed.HealthAlert(alert)
+}
+
 // start starts the node by registering the storage instance for the
 // RPC service "Node" and initializing stores for each specified
 // engine. Launches periodic store gossiping in a goroutine.
 func (n *Node) start(rpcServer *rpc.Server, addr net.Addr, engines []engine.Engine, attrs roachpb.Attributes) error {
 	n.initDescriptor(addr, attrs)
+	n.engines = engines
 
 	// Start status monitor.
 	n.status.StartMonitorFeed(n.ctx.EventFeed)
 
-	// Initialize stores, including bootstrapping new ones.
-	if err := n.initStores(engines, n.stopper); err != nil {
+	// Register the Init and Health RPCs ahead of initializing stores below,
+	// so that an operator can always run `cockroach init` against this node,
+	// and always poll its health, even while it's blocked waiting for its
+	// stores to be initialized.
+	const initMethod = "Node.Init"
+	if err := rpcServer.Register(initMethod, n.executeInit, &InitRequest{}); err != nil {
+		log.Fatalf("unable to register init service with RPC server: %s", err)
+	}
+	const healthMethod = "Node.Health"
+	if err := rpcServer.Register(healthMethod, n.executeHealth, &HealthRequest{}); err != nil {
+		log.Fatalf("unable to register health service with RPC server: %s", err)
+	}
+
+	// Initialize stores. A node started with no initialized stores and no
+	// --join target no longer bootstraps itself implicitly: it blocks and
+	// waits for an explicit `cockroach init` to be run against it (see
+	// Node.Init, waitForInit). engineMu serializes this against executeInit,
+	// which also constructs Store instances over the same engines when
+	// handling an Init RPC.
+	n.engineMu.Lock()
+	err := n.initStores(engines, n.stopper)
+	n.engineMu.Unlock()
+	if err != nil {
 		if err == errNeedsBootstrap {
-			// This node has no initialized stores and no way to connect to
-			// an existing cluster, so we bootstrap it.
-			clusterID, err := bootstrapCluster(engines)
-			if err != nil {
-				return err
-			}
-			log.Infof("**** cluster %s has been created", clusterID)
-			log.Infof("**** add additional nodes by specifying --join=%s", addr)
-			// Make sure we add the node as a resolver.
-			selfResolver, err := resolver.NewResolverFromAddress(addr)
+			n.waitForInit(addr)
+			n.engineMu.Lock()
+			err = n.initStores(engines, n.stopper)
+			n.engineMu.Unlock()
 			if err != nil {
 				return err
 			}
-			n.ctx.Gossip.SetResolvers([]resolver.Resolver{selfResolver})
-			// After bootstrapping, try again to initialize the stores.
-			if err := n.initStores(engines, n.stopper); err != nil {
-				return err
-			}
 		} else {
 			return err
 		}
 	}
+	n.setInitialized()
 
 	n.startedAt = n.ctx.Clock.Now().WallTime
 
 	// Initialize publisher for Node Events. This requires the NodeID, which is
 	// initialized by initStores(); because of this, some Store initialization
-	// events will precede the StartNodeEvent on the feed.
-	n.feed = status.NewNodeEventFeed(n.Descriptor.NodeID, n.ctx.EventFeed)
+	// events will precede the StartNodeEvent on the feed. setFeed marks the
+	// feed ready for runHealthChecks, which may already be running
+	// concurrently via Node.Health, registered above.
+	n.setFeed(status.NewNodeEventFeed(n.Descriptor.NodeID, n.ctx.EventFeed))
 	n.feed.StartNode(n.Descriptor, n.startedAt)
 
 	n.startPublishStatuses(n.stopper)
 	n.startGossip(n.stopper)
 
-	// Register the RPC methods we support last as doing so allows RPCs to be
+	// Register the remaining RPC methods last as doing so allows RPCs to be
 	// received which may access state initialized above without locks.
 	const method = "Node.Batch"
 	if err := rpcServer.Register(method, n.executeCmd, &roachpb.BatchRequest{}); err != nil {
@@ -301,6 +514,184 @@ func (n *Node) start(rpcServer *rpc.Server, addr net.Addr, engines []engine.Engi
 	return nil
 }
 
+// waitForInit blocks until an operator issues an Init RPC against this node
+// (see executeInit). It's only reached when this node has no initialized
+// stores and no configured --join resolvers, so there is no peer to learn a
+// cluster ID from via gossip; the only way to make forward progress is an
+// Init RPC directed at this node specifically. While it waits, it advertises
+// itself as a resolver so that `cockroach init` can be pointed at it, and
+// periodically logs a warning so the condition is visible without relying
+// on an operator already knowing to look for it.
+func (n *Node) waitForInit(addr net.Addr) {
+	log.Warningf("no initialized stores found; node is waiting for `cockroach init` to be run "+
+		"against it (other nodes can then join it by specifying --join=%s)", addr)
+	n.advertiseUninitialized(addr)
+	n.blockUntilInitialized()
+}
+
+// advertiseUninitialized adds this node as a gossip resolver, so that
+// `cockroach init` and other joiners started with --join=addr can reach it
+// while it's blocked in blockUntilInitialized.
+func (n *Node) advertiseUninitialized(addr net.Addr) {
+	selfResolver, err := resolver.NewResolverFromAddress(addr)
+	if err != nil {
+		log.Fatalf("unable to construct resolver from own address %s: %s", addr, err)
+	}
+	n.ctx.Gossip.SetResolvers([]resolver.Resolver{selfResolver})
+	n.initMu.Lock()
+	n.selfAdvertised = true
+	n.initMu.Unlock()
+}
+
+// isSelfAdvertised returns true while this node's only gossip resolver is
+// its own address, set by advertiseUninitialized while waiting for
+// `cockroach init`.
+func (n *Node) isSelfAdvertised() bool {
+	n.initMu.Lock()
+	defer n.initMu.Unlock()
+	return n.selfAdvertised
+}
+
+// hasJoinTarget returns true if this node has a configured gossip resolver
+// pointing somewhere other than its own address, i.e. it was started with
+// --join against another node and is off in connectGossip rather than
+// waitForInit. executeInit uses this to reject an Init RPC aimed at the
+// wrong node: bootstrapping here would stamp a new cluster into this
+// node's engines without that node's start() goroutine ever noticing, since
+// it isn't waiting on initDoneCh.
+func (n *Node) hasJoinTarget() bool {
+	return resolversPointElsewhere(n.ctx.Gossip.GetResolvers(), n.Descriptor.Address)
+}
+
+// resolversPointElsewhere reports whether resolvers contains at least one
+// address other than self. Factored out of hasJoinTarget so the
+// address-matching logic can be exercised without a live gossip.Gossip.
+func resolversPointElsewhere(resolvers []resolver.Resolver, self util.UnresolvedAddr) bool {
+	for _, r := range resolvers {
+		addr, err := r.GetAddress()
+		if err != nil {
+			continue
+		}
+		if uaddr, ok := addr.(*util.UnresolvedAddr); !ok || *uaddr != self {
+			return true
+		}
+	}
+	return false
+}
+
+// blockUntilInitialized blocks until n.initDoneCh is closed by executeInit,
+// periodically logging a warning, or until the stopper requests a shutdown.
+func (n *Node) blockUntilInitialized() {
+	ticker := time.NewTicker(uninitializedWarnInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.initDoneCh:
+			return
+		case <-ticker.C:
+			log.Warningf("still waiting for `cockroach init` to be run against this node")
+		case <-n.stopper.ShouldStop():
+			return
+		}
+	}
+}
+
+// setInitialized records that this node's stores are initialized, rejecting
+// any subsequent Init RPC. It's idempotent and safe to call regardless of
+// whether the node bootstrapped itself via Init, joined an existing
+// cluster, or found already-initialized stores on disk at startup.
+func (n *Node) setInitialized() {
+	n.initMu.Lock()
+	defer n.initMu.Unlock()
+	n.initialized = true
+}
+
+// isInitialized returns true once this node's stores are known to be
+// initialized.
+func (n *Node) isInitialized() bool {
+	n.initMu.Lock()
+	defer n.initMu.Unlock()
+	return n.initialized
+}
+
+// executeInit is exposed as the "Node.Init" RPC. It is the only way to make
+// forward progress on a node that was started with no initialized stores
+// and no --join target: cockroach no longer bootstraps a new cluster
+// implicitly just because a node happens to come up with empty stores.
+// Init bootstraps this node as the seed of a new cluster, allocating a
+// fresh cluster UUID and the first range, and wakes up the node's start()
+// goroutine (blocked in waitForInit) to finish joining the new cluster.
+// Calling Init against a node that is already initialized is an error;
+// operators should instead specify --join against an existing node. Calling
+// it against a node that was itself started with --join is also an error:
+// that node's start() goroutine is off in connectGossip, not waitForInit,
+// and would never notice initDoneCh closing, leaving it permanently wedged
+// with a cluster stamped into its engines but never wired into n.stores.
+//
+// This change lands the RPC and server-side state machine only; the
+// `cockroach init` CLI subcommand that issues this RPC on an operator's
+// behalf is tracked as a separate follow-up and is not yet implemented.
+// Until it lands, Init can only be driven directly against the RPC.
+func (n *Node) executeInit(argsI proto.Message) (proto.Message, error) {
+	if n.isInitialized() {
+		return nil, errAlreadyInitialized
+	}
+	if n.hasJoinTarget() {
+		return nil, errHasJoinTarget
+	}
+
+	n.initMu.Lock()
+	if n.initialized {
+		n.initMu.Unlock()
+		return nil, errAlreadyInitialized
+	}
+	n.initialized = true
+	n.initMu.Unlock()
+
+	n.engineMu.Lock()
+	clusterID, err := bootstrapCluster(n.engines)
+	n.engineMu.Unlock()
+	if err != nil {
+		n.initMu.Lock()
+		n.initialized = false
+		n.initMu.Unlock()
+		return nil, err
+	}
+	log.Infof("**** cluster %s has been created", clusterID)
+	log.Infof("**** add additional nodes by specifying --join=%s", n.Descriptor.Address)
+	close(n.initDoneCh)
+
+	return &InitResponse{ClusterID: clusterID}, nil
+}
+
+// InitRequest is the argument to the "Node.Init" RPC. It carries no
+// payload; the responding node allocates a fresh cluster UUID.
+type InitRequest struct{}
+
+// Reset implements proto.Message.
+func (*InitRequest) Reset() {}
+
+// String implements proto.Message.
+func (*InitRequest) String() string { return "InitRequest{}" }
+
+// ProtoMessage implements proto.Message.
+func (*InitRequest) ProtoMessage() {}
+
+// InitResponse is returned by the "Node.Init" RPC and reports the cluster ID
+// allocated for the newly bootstrapped cluster.
+type InitResponse struct {
+	ClusterID string
+}
+
+// Reset implements proto.Message.
+func (*InitResponse) Reset() {}
+
+// String implements proto.Message.
+func (r *InitResponse) String() string { return fmt.Sprintf("InitResponse{ClusterID: %s}", r.ClusterID) }
+
+// ProtoMessage implements proto.Message.
+func (*InitResponse) ProtoMessage() {}
+
 // initStores initializes the Stores map from ID to Store. Stores are
 // added to the local sender if already bootstrapped. A bootstrapped
 // Store has a valid ident with cluster, node and Store IDs set. If
@@ -336,8 +727,10 @@ func (n *Node) initStores(engines []engine.Engine, stopper *stop.Stopper) error
 		n.stores.AddStore(s)
 	}
 
-	// If there are no initialized stores and no gossip resolvers,
-	// bootstrap this node as the seed of a new cluster.
+	// If there are no initialized stores and no gossip resolvers, this node
+	// has no way of discovering an existing cluster to join, so it's up to
+	// an operator to run `cockroach init` against it (see errNeedsBootstrap
+	// and Node.waitForInit).
 	if n.stores.GetStoreCount() == 0 {
 		resolvers := n.ctx.Gossip.GetResolvers()
 		// Check for the case of uninitialized node having only itself specified as join host.
@@ -351,6 +744,16 @@ func (n *Node) initStores(engines []engine.Engine, stopper *stop.Stopper) error
 				}
 			}
 		}
+	} else {
+		// This node found already-bootstrapped stores on disk: it's part of an
+		// established cluster regardless of what's below, which for a restart
+		// can block on connectGossip for as long as it takes to reconnect to
+		// the gossip network. Mark it initialized now rather than after that
+		// wait, so a Node.Health poll landing during a cluster-wide restart
+		// doesn't see a false "waiting for cockroach init" alert, and so
+		// executeInit rejects an Init RPC against this node immediately
+		// instead of relying on bootstrapCluster's incidental ident check.
+		n.setInitialized()
 	}
 
 	// Verify all initialized stores agree on cluster and node IDs.
@@ -514,11 +917,106 @@ func (n *Node) startPublishStatuses(stopper *stop.Stopper) {
 	})
 }
 
-// publishStoreStatuses calls publishStatus on each store on the node.
+// publishStoreStatuses calls publishStatus on each store on the node, then
+// runs the node's health checkers.
 func (n *Node) publishStoreStatuses() error {
-	return n.stores.VisitStores(func(store *storage.Store) error {
+	if err := n.stores.VisitStores(func(store *storage.Store) error {
 		return store.PublishStatus()
-	})
+	}); err != nil {
+		return err
+	}
+	n.runHealthChecks()
+	return nil
+}
+
+// runHealthChecks evaluates each of the node's registered HealthCheckers,
+// updating n.healthAlerts so that Node.Health always reflects the current
+// state. A HealthAlert is published to the event feed only when it first
+// appears, not on every tick it remains active, so a long-lived condition
+// produces a single feed entry rather than one every publishStatusInterval.
+// An alert's FirstSeen timestamp is carried forward across ticks while its
+// underlying condition persists, and the alert is cleared once its checker
+// stops reporting it, so Node.Health never returns stale entries. It's safe
+// to call before the node's event feed is assigned (see setFeed), e.g.
+// while a node is blocked in waitForInit: the feed publish is simply
+// skipped until then.
+func (n *Node) runHealthChecks() {
+	n.healthMu.Lock()
+	defer n.healthMu.Unlock()
+
+	seen := make(map[string]struct{}, len(n.healthCheckers))
+	for _, check := range n.healthCheckers {
+		alert := check(n)
+		if alert == nil {
+			continue
+		}
+		seen[alert.Check] = struct{}{}
+		prev, existed := n.healthAlerts[alert.Check]
+		if existed {
+			alert.FirstSeen = prev.FirstSeen
+		} else {
+			alert.FirstSeen = n.ctx.Clock.Now().WallTime
+		}
+		n.healthAlerts[alert.Check] = *alert
+		if !existed {
+			n.publishHealthAlert(*alert)
+		}
+	}
+	for check := range n.healthAlerts {
+		if _, ok := seen[check]; !ok {
+			delete(n.healthAlerts, check)
+		}
+	}
+}
+
+// HealthRequest is the argument to the "Node.Health" RPC. It carries no
+// payload.
+type HealthRequest struct{}
+
+// Reset implements proto.Message.
+func (*HealthRequest) Reset() {}
+
+// String implements proto.Message.
+func (*HealthRequest) String() string { return "HealthRequest{}" }
+
+// ProtoMessage implements proto.Message.
+func (*HealthRequest) ProtoMessage() {}
+
+// HealthResponse is returned by the "Node.Health" RPC and reports every
+// HealthAlert currently active for this node.
+type HealthResponse struct {
+	Alerts []HealthAlert
+}
+
+// Reset implements proto.Message.
+func (*HealthResponse) Reset() {}
+
+// String implements proto.Message.
+func (r *HealthResponse) String() string {
+	return fmt.Sprintf("HealthResponse{%d alert(s)}", len(r.Alerts))
+}
+
+// ProtoMessage implements proto.Message.
+func (*HealthResponse) ProtoMessage() {}
+
+// executeHealth is exposed as the "Node.Health" RPC. It runs the health
+// checkers synchronously so that its response is always current, rather
+// than relying solely on the periodic tick from startPublishStatuses; this
+// matters in particular while a node is blocked in waitForInit; since its
+// start() goroutine never reaches startPublishStatuses until initialized,
+// the periodic tick alone would never surface the uninitialized-cluster
+// alert to an operator polling this RPC.
+func (n *Node) executeHealth(argsI proto.Message) (proto.Message, error) {
+	n.runHealthChecks()
+
+	n.healthMu.Lock()
+	defer n.healthMu.Unlock()
+
+	alerts := make([]HealthAlert, 0, len(n.healthAlerts))
+	for _, alert := range n.healthAlerts {
+		alerts = append(alerts, alert)
+	}
+	return &HealthResponse{Alerts: alerts}, nil
 }
 
 // executeCmd interprets the given message as a *roachpb.BatchRequest and sends it
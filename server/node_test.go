@@ -0,0 +1,281 @@
+// Copyright 2014 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package server
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip/resolver"
+	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// newTestNode returns a bare Node suitable for exercising the Init/Health
+// state machine in isolation, without the storage engines and gossip
+// network a fully started Node requires.
+func newTestNode() *Node {
+	return &Node{
+		ctx:          storage.StoreContext{Clock: hlc.NewClock(hlc.UnixNano)},
+		stopper:      stop.NewStopper(),
+		initDoneCh:   make(chan struct{}),
+		healthAlerts: make(map[string]HealthAlert),
+	}
+}
+
+func TestNodeSetInitialized(t *testing.T) {
+	n := newTestNode()
+	defer n.stopper.Stop()
+
+	if n.isInitialized() {
+		t.Fatal("new node should not be initialized")
+	}
+	n.setInitialized()
+	if !n.isInitialized() {
+		t.Fatal("node should be initialized after setInitialized")
+	}
+}
+
+func TestNodeExecuteInitRejectsWhenAlreadyInitialized(t *testing.T) {
+	n := newTestNode()
+	defer n.stopper.Stop()
+
+	n.setInitialized()
+	if _, err := n.executeInit(&InitRequest{}); err != errAlreadyInitialized {
+		t.Fatalf("expected errAlreadyInitialized, got %v", err)
+	}
+}
+
+func TestNodeBlockUntilInitializedUnblocksOnInitDoneCh(t *testing.T) {
+	n := newTestNode()
+	defer n.stopper.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		n.blockUntilInitialized()
+		close(done)
+	}()
+
+	close(n.initDoneCh)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("blockUntilInitialized did not return after initDoneCh was closed")
+	}
+}
+
+func TestNodeRunHealthChecksTracksFirstSeenAndClears(t *testing.T) {
+	n := newTestNode()
+	defer n.stopper.Stop()
+
+	trigger := true
+	n.healthCheckers = []HealthChecker{
+		func(n *Node) *HealthAlert {
+			if !trigger {
+				return nil
+			}
+			return &HealthAlert{Check: "test-check", Severity: AlertSeverityWarning, Message: "triggered"}
+		},
+	}
+
+	n.runHealthChecks()
+	n.healthMu.Lock()
+	alert, ok := n.healthAlerts["test-check"]
+	n.healthMu.Unlock()
+	if !ok {
+		t.Fatal("expected alert to be recorded")
+	}
+	if alert.FirstSeen == 0 {
+		t.Fatal("expected FirstSeen to be set")
+	}
+	firstSeen := alert.FirstSeen
+
+	n.runHealthChecks()
+	n.healthMu.Lock()
+	alert = n.healthAlerts["test-check"]
+	n.healthMu.Unlock()
+	if alert.FirstSeen != firstSeen {
+		t.Fatalf("expected FirstSeen to be carried forward across ticks, got %d, want %d",
+			alert.FirstSeen, firstSeen)
+	}
+
+	trigger = false
+	n.runHealthChecks()
+	n.healthMu.Lock()
+	_, ok = n.healthAlerts["test-check"]
+	n.healthMu.Unlock()
+	if ok {
+		t.Fatal("expected alert to be cleared once its checker stops reporting it")
+	}
+}
+
+// TestNodePublishHealthAlertGatedByFeedReady guards against the race fixed
+// in the dfee5dc commit, where publishHealthAlert gated on n.Descriptor.NodeID
+// instead of whether setFeed had actually run: a health check tick landing
+// between RPC registration and setFeed would touch a zero-value feed.
+func TestNodePublishHealthAlertGatedByFeedReady(t *testing.T) {
+	n := newTestNode()
+	defer n.stopper.Stop()
+
+	if n.feedReady {
+		t.Fatal("feedReady should be false before setFeed is called")
+	}
+
+	// Before setFeed, n.feed is a zero-value status.NodeEventFeed; publishing
+	// must no-op rather than call through to it.
+	n.publishHealthAlert(HealthAlert{Check: "test-check"})
+
+	n.setFeed(status.NewNodeEventFeed(1, util.NewFeed(n.stopper)))
+	if !n.feedReady {
+		t.Fatal("feedReady should be true after setFeed is called")
+	}
+
+	// After setFeed, publishing against the now-valid feed must not panic.
+	n.publishHealthAlert(HealthAlert{Check: "test-check"})
+}
+
+func TestNodeExecuteHealthReportsActiveAlerts(t *testing.T) {
+	n := newTestNode()
+	defer n.stopper.Stop()
+
+	n.healthCheckers = []HealthChecker{checkUninitializedCluster}
+
+	respI, err := n.executeHealth(&HealthRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := respI.(*HealthResponse)
+	if len(resp.Alerts) != 1 || resp.Alerts[0].Check != "uninitialized-cluster" {
+		t.Fatalf("expected a single uninitialized-cluster alert, got %+v", resp.Alerts)
+	}
+
+	n.setInitialized()
+	respI, err = n.executeHealth(&HealthRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp = respI.(*HealthResponse)
+	if len(resp.Alerts) != 0 {
+		t.Fatalf("expected no alerts once initialized, got %+v", resp.Alerts)
+	}
+}
+
+// TestNodeInitStoresMarksInitializedBeforeGossipConnects guards against the
+// race where initStores deferred n.setInitialized() until after it
+// returned, even though its pre-existing-stores branch (stores found
+// already bootstrapped on disk) is confirmed well before that: connectGossip
+// still has to block on a real network round-trip to <-n.ctx.Gossip.Connected,
+// which during a cluster-wide restart can take seconds. A Node.Health poll
+// or Node.Init RPC landing in that window saw a fully-initialized node
+// falsely reported (and falsely acceptable for Init) as waiting for
+// `cockroach init`. initStores now calls setInitialized as soon as its
+// pre-existing-stores branch is confirmed, simulated here without a real
+// store/gossip round-trip by calling it directly, matching what that branch
+// now does before connectGossip ever runs.
+func TestNodeInitStoresMarksInitializedBeforeGossipConnects(t *testing.T) {
+	n := newTestNode()
+	defer n.stopper.Stop()
+
+	n.healthCheckers = []HealthChecker{checkUninitializedCluster}
+
+	respI, err := n.executeHealth(&HealthRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp := respI.(*HealthResponse); len(resp.Alerts) != 1 {
+		t.Fatalf("expected uninitialized-cluster alert before stores are confirmed present, got %+v", resp.Alerts)
+	}
+
+	// This simulates initStores's pre-existing-stores branch, reached while
+	// connectGossip (not modeled by newTestNode) would still be blocking a
+	// real start() goroutine.
+	n.setInitialized()
+
+	respI, err = n.executeHealth(&HealthRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp := respI.(*HealthResponse); len(resp.Alerts) != 0 {
+		t.Fatalf("expected no alerts once pre-existing stores are confirmed, got %+v", resp.Alerts)
+	}
+
+	if _, err := n.executeInit(&InitRequest{}); err != errAlreadyInitialized {
+		t.Fatalf("expected Init against an already-bootstrapped node to be rejected immediately, got %v", err)
+	}
+}
+
+// TestNodeCheckUnreachableJoinPeersSkipsWhileSelfAdvertised guards against
+// checkUnreachableJoinPeers misreading advertiseUninitialized's self-pointing
+// resolver (added purely so `cockroach init` and joiners can find a node
+// blocked in waitForInit) as an unreachable --join target. Before this gate,
+// a node waiting for init raised both checkUninitializedCluster's correct
+// alert and this checker's "unable to reach any of 1 configured join
+// address(es)" alert pointing at the node's own, unreachable-because-it's-us
+// address.
+func TestNodeCheckUnreachableJoinPeersSkipsWhileSelfAdvertised(t *testing.T) {
+	n := newTestNode()
+	defer n.stopper.Stop()
+
+	// n.ctx.Gossip is nil in newTestNode; reaching it here would panic, so
+	// this also asserts the checker returns before touching gossip at all.
+	n.initMu.Lock()
+	n.selfAdvertised = true
+	n.initMu.Unlock()
+
+	if alert := checkUnreachableJoinPeers(n); alert != nil {
+		t.Fatalf("expected no alert while self-advertised, got %+v", alert)
+	}
+}
+
+// TestNodeResolversPointElsewhere covers the address-matching logic
+// executeInit relies on (via hasJoinTarget) to tell a node waiting for
+// `cockroach init` (no resolvers, or only a self-advertised one) apart from
+// a node started with --join against a genuine peer.
+func TestNodeResolversPointElsewhere(t *testing.T) {
+	selfAddr, err := net.ResolveTCPAddr("tcp", "127.0.0.1:26257")
+	if err != nil {
+		t.Fatal(err)
+	}
+	self := util.MakeUnresolvedAddr(selfAddr.Network(), selfAddr.String())
+
+	if resolversPointElsewhere(nil, self) {
+		t.Fatal("no resolvers configured should not count as a join target")
+	}
+
+	selfResolver, err := resolver.NewResolverFromAddress(selfAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolversPointElsewhere([]resolver.Resolver{selfResolver}, self) {
+		t.Fatal("a resolver pointing at self (as set by advertiseUninitialized) should not count as a join target")
+	}
+
+	peerAddr, err := net.ResolveTCPAddr("tcp", "127.0.0.1:26258")
+	if err != nil {
+		t.Fatal(err)
+	}
+	peerResolver, err := resolver.NewResolverFromAddress(peerAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resolversPointElsewhere([]resolver.Resolver{peerResolver}, self) {
+		t.Fatal("a resolver pointing at another node (as set by --join) should count as a join target")
+	}
+}